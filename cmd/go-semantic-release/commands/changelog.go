@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/Nightapes/go-semantic-release/pkg/semanticrelease"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -9,6 +11,12 @@ import (
 func init() {
 	changelogCmd.Flags().Bool("checks", false, "Check for missing values and envs")
 	changelogCmd.Flags().StringP("out", "o", "CHANGELOG.md", "Name of the file")
+	changelogCmd.Flags().String("from", "", "Oldest revision to stop at (exclusive), e.g. a previous release tag; regenerates the changelog for a historical range instead of since the last release")
+	changelogCmd.Flags().String("to", "HEAD", "Newest revision to start from, only used when --from is set")
+	changelogCmd.Flags().Bool("full", false, "Regenerate the changelog for every released version instead of just the next one")
+	changelogCmd.Flags().String("since", "", "With --full, only include versions at or after this tag")
+	changelogCmd.Flags().Bool("include-prereleases", false, "With --full, also include prerelease tags")
+	changelogCmd.Flags().Bool("group-by-major", false, "With --full, group released versions under a heading per major version")
 	rootCmd.AddCommand(changelogCmd)
 }
 
@@ -41,11 +49,63 @@ var changelogCmd = &cobra.Command{
 			return err
 		}
 
+		from, err := cmd.Flags().GetString("from")
+		if err != nil {
+			return err
+		}
+
+		to, err := cmd.Flags().GetString("to")
+		if err != nil {
+			return err
+		}
+
+		full, err := cmd.Flags().GetBool("full")
+		if err != nil {
+			return err
+		}
+
+		since, err := cmd.Flags().GetString("since")
+		if err != nil {
+			return err
+		}
+
+		includePrereleases, err := cmd.Flags().GetBool("include-prereleases")
+		if err != nil {
+			return err
+		}
+
+		groupByMajor, err := cmd.Flags().GetBool("group-by-major")
+		if err != nil {
+			return err
+		}
+
+		if full && from != "" {
+			return fmt.Errorf("--full cannot be combined with --from/--to")
+		}
+
 		s, err := semanticrelease.New(readConfig(config), repository, configChecks)
 		if err != nil {
 			return err
 		}
 
+		if full {
+			generatedChangelog, err := s.GenerateFullChangelog(since, includePrereleases, groupByMajor)
+			if err != nil {
+				return err
+			}
+
+			return s.WriteChangeLog(generatedChangelog.Content, file)
+		}
+
+		if from != "" {
+			generatedChangelog, err := s.GetChangelogForRange(to, from)
+			if err != nil {
+				return err
+			}
+
+			return s.WriteChangeLog(generatedChangelog.Content, file)
+		}
+
 		provider, err := s.GetCIProvider()
 		if err != nil {
 			return err
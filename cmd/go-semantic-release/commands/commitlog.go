@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Nightapes/go-semantic-release/internal/analyzer"
+	"github.com/Nightapes/go-semantic-release/internal/gitutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	commitLogCmd.Flags().String("from", "", "Oldest revision to stop at (exclusive), walks to the root commit if empty")
+	commitLogCmd.Flags().String("to", "HEAD", "Newest revision to start from")
+	rootCmd.AddCommand(commitLogCmd)
+}
+
+// commitLogEntry is a single line of the commit-log JSON stream, one conventional-commit
+// parse result per commit.
+type commitLogEntry struct {
+	Hash     string            `json:"hash"`
+	Author   string            `json:"author"`
+	Type     string            `json:"type"`
+	Scope    string            `json:"scope,omitempty"`
+	Subject  string            `json:"subject"`
+	Breaking bool              `json:"breaking"`
+	Footers  map[string]string `json:"footers,omitempty"`
+}
+
+var commitLogCmd = &cobra.Command{
+	Use:   "commit-log",
+	Short: "Print the commits between two revisions as newline delimited JSON",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repository, err := cmd.Flags().GetString("repository")
+		if err != nil {
+			return err
+		}
+
+		from, err := cmd.Flags().GetString("from")
+		if err != nil {
+			return err
+		}
+
+		to, err := cmd.Flags().GetString("to")
+		if err != nil {
+			return err
+		}
+
+		g, err := gitutil.New(repository)
+		if err != nil {
+			return err
+		}
+
+		commits, err := g.GetCommitsInRange(to, from)
+		if err != nil {
+			return err
+		}
+
+		a := analyzer.New()
+		enc := json.NewEncoder(os.Stdout)
+
+		for _, commit := range commits {
+			parsed, err := a.Analyze(commit.Message)
+			if err != nil {
+				return fmt.Errorf("could not parse commit %s: %w", commit.Hash, err)
+			}
+
+			entry := commitLogEntry{
+				Hash:     commit.Hash,
+				Author:   commit.Author,
+				Type:     parsed.Type,
+				Scope:    parsed.Scope,
+				Subject:  parsed.Subject,
+				Breaking: parsed.Breaking,
+				Footers:  parsed.Footers,
+			}
+
+			if err := enc.Encode(entry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+const commitMsgHook = `#!/bin/sh
+exec go-semantic-release validate-commit "$1"
+`
+
+func init() {
+	installHookCmd.Flags().Bool("force", false, "Overwrite an existing commit-msg hook")
+	rootCmd.AddCommand(installHookCmd)
+}
+
+var installHookCmd = &cobra.Command{
+	Use:   "install-hook",
+	Short: "Install a commit-msg git hook that runs validate-commit",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repository, err := cmd.Flags().GetString("repository")
+		if err != nil {
+			return err
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		if err != nil {
+			return err
+		}
+
+		hookPath := filepath.Join(repository, ".git", "hooks", "commit-msg")
+
+		if !force {
+			if _, err := os.Stat(hookPath); err == nil {
+				return fmt.Errorf("%s already exists, pass --force to overwrite it", hookPath)
+			} else if !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := ioutil.WriteFile(hookPath, []byte(commitMsgHook), 0755); err != nil {
+			return fmt.Errorf("could not write commit-msg hook: %w", err)
+		}
+
+		fmt.Printf("Installed commit-msg hook at %s\n", hookPath)
+		return nil
+	},
+}
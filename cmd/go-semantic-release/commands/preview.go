@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/Nightapes/go-semantic-release/internal/gitutil"
+	"github.com/Nightapes/go-semantic-release/pkg/semanticrelease"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	previewCmd.Flags().Bool("checks", false, "Check for missing values and envs")
+	rootCmd.AddCommand(previewCmd)
+}
+
+var previewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "Run the release pipeline in a disposable worktree and print what would be released",
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		config, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		repository, err := cmd.Flags().GetString("repository")
+		if err != nil {
+			return err
+		}
+
+		configChecks, err := cmd.Flags().GetBool("checks")
+		if err != nil {
+			return err
+		}
+
+		g, err := gitutil.New(repository)
+		if err != nil {
+			return err
+		}
+
+		return g.WithWorktree(func(worktree *gitutil.GitUtil) error {
+
+			s, err := semanticrelease.New(readConfig(config), worktree.Path, configChecks)
+			if err != nil {
+				return err
+			}
+
+			provider, err := s.GetCIProvider()
+			if err != nil {
+				return err
+			}
+
+			releaseVersion, err := s.GetNextVersion(provider, true)
+			if err != nil {
+				return err
+			}
+
+			generatedChangelog, err := s.GetChangelog(releaseVersion)
+			if err != nil {
+				return err
+			}
+
+			if err := s.SetVersion(releaseVersion.Version.Original()); err != nil {
+				return err
+			}
+
+			// Tags are refs, which git shares between a repository and every worktree
+			// linked to it, so an actual tag is never created here: doing so would
+			// permanently mutate the real repository, exactly what preview exists to
+			// avoid. Only the working-copy version bump above is worktree-local.
+			fmt.Printf("Would tag %s\n\n%s\n", releaseVersion.Version.Original(), generatedChangelog.Content)
+			return nil
+		})
+	},
+}
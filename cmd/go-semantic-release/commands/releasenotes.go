@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/Nightapes/go-semantic-release/pkg/semanticrelease"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	releaseNotesCmd.Flags().StringP("out", "o", "", "Name of the file, prints to stdout if empty")
+	releaseNotesCmd.Flags().String("from", "", "Oldest revision of the range to stop at (exclusive), e.g. v1.2.0")
+	releaseNotesCmd.Flags().String("to", "HEAD", "Newest revision of the range to start from, e.g. v1.3.0")
+	rootCmd.AddCommand(releaseNotesCmd)
+}
+
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes",
+	Short: "Render release notes for a historical revision range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		repository, err := cmd.Flags().GetString("repository")
+		if err != nil {
+			return err
+		}
+
+		from, err := cmd.Flags().GetString("from")
+		if err != nil {
+			return err
+		}
+
+		if from == "" {
+			return fmt.Errorf("--from is required")
+		}
+
+		to, err := cmd.Flags().GetString("to")
+		if err != nil {
+			return err
+		}
+
+		file, err := cmd.Flags().GetString("out")
+		if err != nil {
+			return err
+		}
+
+		s, err := semanticrelease.New(readConfig(config), repository, false)
+		if err != nil {
+			return err
+		}
+
+		generatedChangelog, err := s.GetChangelogForRange(to, from)
+		if err != nil {
+			return err
+		}
+
+		if file == "" {
+			fmt.Println(generatedChangelog.Content)
+			return nil
+		}
+
+		return s.WriteChangeLog(generatedChangelog.Content, file)
+	},
+}
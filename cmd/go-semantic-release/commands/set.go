@@ -1,12 +1,18 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/Nightapes/go-semantic-release/pkg/semanticrelease"
 
 	"github.com/spf13/cobra"
 )
 
 func init() {
+	setCmd.Flags().Bool("tag", false, "Also create a git tag for the version")
+	setCmd.Flags().Bool("annotated", true, "Create an annotated tag instead of a lightweight one, only used with --tag")
+	setCmd.Flags().Bool("sign", false, "GPG-sign the annotated tag, only used with --tag, requires --signing-key")
+	setCmd.Flags().String("signing-key", "", "Path to an armored GPG private key, only used with --tag --sign")
 	rootCmd.AddCommand(setCmd)
 }
 
@@ -26,11 +32,51 @@ var setCmd = &cobra.Command{
 			return err
 		}
 
+		createTag, err := cmd.Flags().GetBool("tag")
+		if err != nil {
+			return err
+		}
+
+		annotated, err := cmd.Flags().GetBool("annotated")
+		if err != nil {
+			return err
+		}
+
+		sign, err := cmd.Flags().GetBool("sign")
+		if err != nil {
+			return err
+		}
+
+		signingKey, err := cmd.Flags().GetString("signing-key")
+		if err != nil {
+			return err
+		}
+
+		if sign && signingKey == "" {
+			return fmt.Errorf("--sign requires --signing-key to point at an armored GPG private key")
+		}
+
+		if sign && !annotated {
+			return fmt.Errorf("--sign requires --annotated, lightweight tags cannot be signed")
+		}
+
+		if !sign {
+			signingKey = ""
+		}
+
 		s, err := semanticrelease.New(readConfig(config), repository)
 		if err != nil {
 			return err
 		}
 
-		return s.SetVersion(args[0])
+		if err := s.SetVersion(args[0]); err != nil {
+			return err
+		}
+
+		if !createTag {
+			return nil
+		}
+
+		return s.CreateTag(args[0], annotated, signingKey)
 	},
 }
\ No newline at end of file
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/Nightapes/go-semantic-release/pkg/semanticrelease"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	tagCmd.Flags().Bool("annotated", true, "Create an annotated tag instead of a lightweight one")
+	tagCmd.Flags().Bool("sign", false, "GPG-sign the annotated tag, requires --signing-key")
+	tagCmd.Flags().String("signing-key", "", "Path to an armored GPG private key, required when --sign is set")
+	rootCmd.AddCommand(tagCmd)
+}
+
+var tagCmd = &cobra.Command{
+	Use:   "tag [version]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Create a git tag for a release version",
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		config, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		repository, err := cmd.Flags().GetString("repository")
+		if err != nil {
+			return err
+		}
+
+		annotated, err := cmd.Flags().GetBool("annotated")
+		if err != nil {
+			return err
+		}
+
+		sign, err := cmd.Flags().GetBool("sign")
+		if err != nil {
+			return err
+		}
+
+		signingKey, err := cmd.Flags().GetString("signing-key")
+		if err != nil {
+			return err
+		}
+
+		if sign && signingKey == "" {
+			return fmt.Errorf("--sign requires --signing-key to point at an armored GPG private key")
+		}
+
+		if sign && !annotated {
+			return fmt.Errorf("--sign requires --annotated, lightweight tags cannot be signed")
+		}
+
+		if !sign {
+			signingKey = ""
+		}
+
+		s, err := semanticrelease.New(readConfig(config), repository)
+		if err != nil {
+			return err
+		}
+
+		return s.CreateTag(args[0], annotated, signingKey)
+	},
+}
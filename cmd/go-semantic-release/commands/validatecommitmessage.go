@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Nightapes/go-semantic-release/internal/analyzer"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(validateCommitCmd)
+}
+
+var validateCommitCmd = &cobra.Command{
+	Use:   "validate-commit [file]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Validate a commit message against the configured conventional-commit rules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		message, err := readCommitMessage(args)
+		if err != nil {
+			return err
+		}
+
+		analyzerConfig, err := loadAnalyzerConfig(config)
+		if err != nil {
+			return err
+		}
+
+		a := analyzer.NewWithConfig(analyzerConfig)
+
+		if err := a.Validate(message); err != nil {
+			return fmt.Errorf("commit message is invalid: %w", err)
+		}
+
+		return nil
+	},
+}
+
+// loadAnalyzerConfig reads the commit-analyzer rules (allowed types, scope regex,
+// subject length, breaking-change footer requirement) from the commitAnalyzer section
+// of the repo's config file. An empty path, or a config file with no such section,
+// falls back to analyzer.DefaultConfig.
+func loadAnalyzerConfig(path string) (analyzer.Config, error) {
+	if path == "" {
+		return analyzer.DefaultConfig, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return analyzer.Config{}, err
+	}
+
+	var file struct {
+		CommitAnalyzer analyzer.Config `json:"commitAnalyzer"`
+	}
+
+	if err := json.Unmarshal(content, &file); err != nil {
+		return analyzer.Config{}, fmt.Errorf("could not parse commitAnalyzer rules from %s: %w", path, err)
+	}
+
+	return file.CommitAnalyzer, nil
+}
+
+// readCommitMessage reads the raw commit message either from the file given as the
+// first argument, or from stdin if no file was given.
+func readCommitMessage(args []string) (string, error) {
+	if len(args) == 1 {
+		content, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return "", err
+		}
+		return string(content), nil
+	}
+
+	content, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
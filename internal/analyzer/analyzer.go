@@ -0,0 +1,157 @@
+// Package analyzer parses conventional-commit messages and, when a Config is supplied,
+// validates them against repo-specific rules.
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Commit is the parsed conventional-commit representation shared by the changelog
+// pipeline, commit-log and validate-commit.
+type Commit struct {
+	Type     string
+	Scope    string
+	Subject  string
+	Breaking bool
+	Footers  map[string]string
+}
+
+// Config describes the per-repository conventional-commit rules Validate enforces. An
+// empty ScopeRegex or a false RequireBreakingChangeFooter disables that rule; a zero
+// AllowedTypes or MaxSubjectLength is replaced with DefaultConfig's by NewWithConfig.
+type Config struct {
+	AllowedTypes                []string `json:"allowedTypes"`
+	ScopeRegex                  string   `json:"scopeRegex"`
+	MaxSubjectLength            int      `json:"maxSubjectLength"`
+	RequireBreakingChangeFooter bool     `json:"requireBreakingChangeFooter"`
+}
+
+// DefaultConfig is used by New, and by NewWithConfig for any field left at its zero
+// value in the supplied Config.
+var DefaultConfig = Config{
+	AllowedTypes:     []string{"feat", "fix", "docs", "style", "refactor", "perf", "test", "build", "ci", "chore", "revert"},
+	MaxSubjectLength: 100,
+}
+
+var headerPattern = regexp.MustCompile(`^(?P<type>[a-zA-Z]+)(\((?P<scope>[^)]+)\))?(?P<breaking>!)?: (?P<subject>.+)$`)
+
+// Analyzer parses conventional-commit messages and, when configured, validates them.
+type Analyzer struct {
+	config Config
+}
+
+// New returns an Analyzer that only parses commits, without enforcing any repo-specific
+// rules.
+func New() *Analyzer {
+	return &Analyzer{config: DefaultConfig}
+}
+
+// NewWithConfig returns an Analyzer that validates against config, falling back to
+// DefaultConfig for any field left unset.
+func NewWithConfig(config Config) *Analyzer {
+	if len(config.AllowedTypes) == 0 {
+		config.AllowedTypes = DefaultConfig.AllowedTypes
+	}
+
+	if config.MaxSubjectLength == 0 {
+		config.MaxSubjectLength = DefaultConfig.MaxSubjectLength
+	}
+
+	return &Analyzer{config: config}
+}
+
+// Analyze parses message into its conventional-commit parts. It does not check the
+// result against Config; use Validate for that.
+func (a *Analyzer) Analyze(message string) (*Commit, error) {
+
+	lines := strings.SplitN(message, "\n", 2)
+	header := strings.TrimSpace(lines[0])
+
+	match := headerPattern.FindStringSubmatch(header)
+	if match == nil {
+		return nil, fmt.Errorf("commit header %q does not match the expected \"type(scope)!: subject\" format", header)
+	}
+
+	commit := &Commit{
+		Type:     match[headerPattern.SubexpIndex("type")],
+		Scope:    match[headerPattern.SubexpIndex("scope")],
+		Subject:  match[headerPattern.SubexpIndex("subject")],
+		Breaking: match[headerPattern.SubexpIndex("breaking")] == "!",
+		Footers:  map[string]string{},
+	}
+
+	if len(lines) == 2 {
+		for _, line := range strings.Split(lines[1], "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+
+			if strings.HasPrefix(line, "BREAKING CHANGE:") {
+				commit.Breaking = true
+				commit.Footers["BREAKING CHANGE"] = strings.TrimSpace(strings.TrimPrefix(line, "BREAKING CHANGE:"))
+				continue
+			}
+
+			if idx := strings.Index(line, ": "); idx > 0 {
+				commit.Footers[line[:idx]] = line[idx+2:]
+			}
+		}
+	}
+
+	return commit, nil
+}
+
+// Validate parses message and checks it against the Analyzer's Config, returning a
+// single error describing every rule that failed.
+func (a *Analyzer) Validate(message string) error {
+
+	commit, err := a.Analyze(message)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+
+	if len(a.config.AllowedTypes) > 0 && !containsString(a.config.AllowedTypes, commit.Type) {
+		problems = append(problems, fmt.Sprintf("type %q is not one of %v", commit.Type, a.config.AllowedTypes))
+	}
+
+	if a.config.ScopeRegex != "" && commit.Scope != "" {
+		re, err := regexp.Compile(a.config.ScopeRegex)
+		if err != nil {
+			return fmt.Errorf("invalid scopeRegex %q in config: %w", a.config.ScopeRegex, err)
+		}
+
+		if !re.MatchString(commit.Scope) {
+			problems = append(problems, fmt.Sprintf("scope %q does not match %q", commit.Scope, a.config.ScopeRegex))
+		}
+	}
+
+	if a.config.MaxSubjectLength > 0 && len(commit.Subject) > a.config.MaxSubjectLength {
+		problems = append(problems, fmt.Sprintf("subject is %d characters, longer than the %d character limit", len(commit.Subject), a.config.MaxSubjectLength))
+	}
+
+	if a.config.RequireBreakingChangeFooter && commit.Breaking {
+		if _, ok := commit.Footers["BREAKING CHANGE"]; !ok {
+			problems = append(problems, "breaking commits must include a \"BREAKING CHANGE:\" footer explaining the break")
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
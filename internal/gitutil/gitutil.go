@@ -4,10 +4,15 @@ package gitutil
 import (
 	"fmt"
 	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
 	"sort"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/Nightapes/go-semantic-release/internal/shared"
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -18,6 +23,7 @@ import (
 // GitUtil struct
 type GitUtil struct {
 	Repository *git.Repository
+	Path       string
 }
 
 // New GitUtil struct and open git repository
@@ -28,6 +34,7 @@ func New(folder string) (*GitUtil, error) {
 	}
 	utils := &GitUtil{
 		Repository: r,
+		Path:       folder,
 	}
 	return utils, nil
 
@@ -76,49 +83,89 @@ func (g *GitUtil) GetBranch() (string, error) {
 	return ref.Name().Short(), nil
 }
 
-// GetLastVersion from git tags
-func (g *GitUtil) GetLastVersion() (*semver.Version, string, error) {
+// TaggedVersion pairs a semver tag with the commit hash it points at and the date it
+// was tagged. Lightweight tags carry no date, so for them TaggerDate is the committer
+// date of the tagged commit.
+type TaggedVersion struct {
+	Version    *semver.Version
+	Hash       string
+	TaggerDate time.Time
+}
 
-	var tags []*semver.Version
+// GetAllVersions returns every valid semver tag in the repository, sorted newest first.
+func (g *GitUtil) GetAllVersions() ([]TaggedVersion, error) {
 
 	gitTags, err := g.Repository.Tags()
-
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
+	var versions []TaggedVersion
+
 	err = gitTags.ForEach(func(p *plumbing.Reference) error {
 		v, err := semver.NewVersion(p.Name().Short())
 		log.Tracef("Tag %+v with hash: %s", p.Name().Short(), p.Hash())
 
-		if err == nil {
-			tags = append(tags, v)
-		} else {
+		if err != nil {
 			log.Debugf("Tag %s is not a valid version, skip", p.Name().Short())
+			return nil
+		}
+
+		commitHash := p.Hash()
+		var taggerDate time.Time
+
+		tagObject, err := g.Repository.TagObject(p.Hash())
+		switch err {
+		case nil:
+			taggerDate = tagObject.Tagger.When
+			if commit, cErr := tagObject.Commit(); cErr == nil {
+				commitHash = commit.Hash
+			}
+		case plumbing.ErrObjectNotFound:
+			if commit, cErr := g.Repository.CommitObject(p.Hash()); cErr == nil {
+				taggerDate = commit.Committer.When
+			}
+		default:
+			return err
 		}
+
+		versions = append(versions, TaggedVersion{
+			Version:    v,
+			Hash:       commitHash.String(),
+			TaggerDate: taggerDate,
+		})
+
 		return nil
 	})
 
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	sort.Sort(sort.Reverse(semver.Collection(tags)))
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version.GreaterThan(versions[j].Version)
+	})
 
-	if len(tags) == 0 {
-		log.Debugf("Found no tags")
-		return nil, "", nil
-	}
+	return versions, nil
+}
 
-	log.Debugf("Found old version %s", tags[0].String())
+// GetLastVersion from git tags
+func (g *GitUtil) GetLastVersion() (*semver.Version, string, error) {
 
-	tag, err := g.Repository.Tag(tags[0].Original())
+	versions, err := g.GetAllVersions()
 	if err != nil {
 		return nil, "", err
 	}
 
-	log.Debugf("Found old hash %s", tag.Hash().String())
-	return tags[0], tag.Hash().String(), nil
+	if len(versions) == 0 {
+		log.Debugf("Found no tags")
+		return nil, "", nil
+	}
+
+	log.Debugf("Found old version %s", versions[0].Version.String())
+	log.Debugf("Found old hash %s", versions[0].Hash)
+
+	return versions[0].Version, versions[0].Hash, nil
 }
 
 // GetCommits from git hash to HEAD
@@ -169,3 +216,161 @@ func (g *GitUtil) GetCommits(lastTagHash string) ([]shared.Commit, error) {
 
 	return l, nil
 }
+
+// GetCommitsInRange returns the commits reachable from fromRev, stopping once toRev is
+// reached. fromRev and toRev may be tag names, short or long hashes, or relative
+// revisions such as HEAD~N, anything ResolveRevision accepts. If toRev is empty the log
+// is walked all the way to the root commit.
+func (g *GitUtil) GetCommitsInRange(fromRev, toRev string) ([]shared.Commit, error) {
+
+	fromHash, err := g.Repository.ResolveRevision(plumbing.Revision(fromRev))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not resolve revision %s", fromRev)
+	}
+
+	var toHash *plumbing.Hash
+	if toRev != "" {
+		toHash, err = g.Repository.ResolveRevision(plumbing.Revision(toRev))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not resolve revision %s", toRev)
+		}
+	}
+
+	cIter, err := g.Repository.Log(&git.LogOptions{From: *fromHash, Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]shared.Commit, 0)
+	var foundEnd bool
+
+	err = cIter.ForEach(func(c *object.Commit) error {
+
+		if toHash != nil && c.Hash == *toHash {
+			log.Debugf("Found commit with hash %s, will stop here", c.Hash.String())
+			foundEnd = true
+			return storer.ErrStop
+		}
+
+		log.Tracef("Found commit with hash %s", c.Hash.String())
+		commits = append(commits, shared.Commit{
+			Message: c.Message,
+			Author:  c.Committer.Name,
+			Hash:    c.Hash.String(),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not read commits, check git clone depth in your ci")
+	}
+
+	if toHash != nil && !foundEnd {
+		return nil, fmt.Errorf("revision %s is not an ancestor of %s, refusing to return the full history", toRev, fromRev)
+	}
+
+	return commits, nil
+}
+
+// CreateTag creates a git tag named name at HEAD. When annotated is true an annotated
+// tag object is created with message as its body; otherwise a lightweight tag is created
+// and message is ignored. When signingKeyPath is non-empty the armored private key it
+// points to is used to GPG-sign the annotated tag.
+func (g *GitUtil) CreateTag(name, message string, annotated bool, signingKeyPath string) (*plumbing.Reference, error) {
+
+	if signingKeyPath != "" && !annotated {
+		return nil, fmt.Errorf("cannot create a signed tag: lightweight tags cannot be signed, pass annotated=true")
+	}
+
+	head, err := g.Repository.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts *git.CreateTagOptions
+
+	if annotated {
+		opts = &git.CreateTagOptions{Message: message}
+
+		if signingKeyPath != "" {
+			entity, err := loadSigningEntity(signingKeyPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not load signing key")
+			}
+			opts.SignKey = entity
+		}
+	}
+
+	log.Debugf("Creating tag %s at %s", name, head.Hash().String())
+
+	return g.Repository.CreateTag(name, head.Hash(), opts)
+}
+
+// loadSigningEntity reads the first openpgp.Entity found in the armored keyring at path.
+func loadSigningEntity(path string) (*openpgp.Entity, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entityList, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entityList) == 0 {
+		return nil, fmt.Errorf("no signing key found in %s", path)
+	}
+
+	return entityList[0], nil
+}
+
+// WithWorktree creates a detached git worktree at HEAD in a temporary directory and
+// invokes fn with a GitUtil opened on it, removing and pruning the worktree again
+// afterwards regardless of the outcome. This lets callers run the full release pipeline
+// without mutating the working copy or index. go-git has no native worktree support, so
+// the plain git binary is shelled out to, mirroring how the rest of the toolchain
+// expects git to be on PATH.
+func (g *GitUtil) WithWorktree(fn func(*GitUtil) error) error {
+
+	dir, err := ioutil.TempDir("", "go-semantic-release-worktree-")
+	if err != nil {
+		return err
+	}
+
+	head, err := g.Repository.Head()
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	addCmd := exec.Command("git", "worktree", "add", "--detach", dir, head.Hash().String())
+	addCmd.Dir = g.Path
+	if out, err := addCmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return errors.Wrapf(err, "could not add worktree: %s", string(out))
+	}
+
+	defer func() {
+		removeCmd := exec.Command("git", "worktree", "remove", "--force", dir)
+		removeCmd.Dir = g.Path
+		if out, err := removeCmd.CombinedOutput(); err != nil {
+			log.Warnf("could not remove worktree %s: %s", dir, string(out))
+		}
+
+		pruneCmd := exec.Command("git", "worktree", "prune")
+		pruneCmd.Dir = g.Path
+		if out, err := pruneCmd.CombinedOutput(); err != nil {
+			log.Warnf("could not prune worktrees: %s", string(out))
+		}
+	}()
+
+	worktree, err := New(dir)
+	if err != nil {
+		return err
+	}
+
+	return fn(worktree)
+}
@@ -0,0 +1,102 @@
+package gitutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// initTestRepo creates a throwaway git repository with three linear commits and
+// returns a GitUtil opened on it alongside the commit hashes, oldest first.
+func initTestRepo(t *testing.T) (*GitUtil, []string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s: %s", args, err, out)
+		}
+	}
+
+	run("init")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "test")
+
+	var hashes []string
+
+	for i := 0; i < 3; i++ {
+		filePath := filepath.Join(dir, "file.txt")
+		if err := ioutil.WriteFile(filePath, []byte(fmt.Sprintf("line %d\n", i)), 0644); err != nil {
+			t.Fatalf("could not write file: %s", err)
+		}
+
+		run("add", ".")
+		run("commit", "-m", fmt.Sprintf("feat: commit %d", i))
+
+		out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+		if err != nil {
+			t.Fatalf("could not read HEAD: %s", err)
+		}
+
+		hashes = append(hashes, strings.TrimSpace(string(out)))
+	}
+
+	g, err := New(dir)
+	if err != nil {
+		t.Fatalf("could not open repository: %s", err)
+	}
+
+	return g, hashes
+}
+
+func TestGetCommitsInRange(t *testing.T) {
+	g, hashes := initTestRepo(t)
+
+	commits, err := g.GetCommitsInRange(hashes[2], hashes[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+
+	if commits[0].Hash != hashes[2] || commits[1].Hash != hashes[1] {
+		t.Fatalf("commits not returned newest first: %+v", commits)
+	}
+}
+
+func TestGetCommitsInRangeNoStop(t *testing.T) {
+	g, hashes := initTestRepo(t)
+
+	commits, err := g.GetCommitsInRange(hashes[2], "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(commits) != 3 {
+		t.Fatalf("expected all 3 commits when toRev is empty, got %d", len(commits))
+	}
+}
+
+func TestGetCommitsInRangeUnreachableTo(t *testing.T) {
+	g, hashes := initTestRepo(t)
+
+	if _, err := g.GetCommitsInRange(hashes[0], hashes[2]); err == nil {
+		t.Fatal("expected an error when toRev is not an ancestor of fromRev")
+	}
+}
+
+func TestCreateTagLightweightCannotBeSigned(t *testing.T) {
+	g, _ := initTestRepo(t)
+
+	if _, err := g.CreateTag("v1.0.0", "", false, "testdata/does-not-matter.key"); err == nil {
+		t.Fatal("expected an error when signing a lightweight tag")
+	}
+}
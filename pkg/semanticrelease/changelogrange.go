@@ -0,0 +1,43 @@
+package semanticrelease
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nightapes/go-semantic-release/internal/analyzer"
+	"github.com/Nightapes/go-semantic-release/internal/shared"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetChangelogForRange renders a changelog for the commits GitUtil.GetCommitsInRange
+// returns for (to, from]: from is the newest commit included, to the oldest one left
+// out. It reuses the conventional-commit analyzer so a range
+// changelog reads the same as one generated for a normal release.
+func (s *Release) GetChangelogForRange(from, to string) (*ChangelogResult, error) {
+
+	commits, err := s.Git.GetCommitsInRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderChangelog(commits)
+}
+
+func renderChangelog(commits []shared.Commit) (*ChangelogResult, error) {
+
+	a := analyzer.New()
+
+	var content strings.Builder
+
+	for _, commit := range commits {
+		parsed, err := a.Analyze(commit.Message)
+		if err != nil {
+			log.Debugf("Could not parse commit %s, skipping from changelog: %s", commit.Hash, err)
+			continue
+		}
+
+		fmt.Fprintf(&content, "* %s: %s (%.7s)\n", parsed.Type, parsed.Subject, commit.Hash)
+	}
+
+	return &ChangelogResult{Content: content.String()}, nil
+}
@@ -0,0 +1,77 @@
+package semanticrelease
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+// GenerateFullChangelog renders a single changelog with one section per released
+// version, pairing the tags GitUtil.GetAllVersions discovers in descending order and
+// running GetChangelogForRange over each pair. When since is non-empty only versions at
+// or after that tag are included. Prerelease tags are skipped unless includePrereleases
+// is set. When groupByMajor is set, each major version gets its own heading above its
+// versions' sections.
+func (s *Release) GenerateFullChangelog(since string, includePrereleases, groupByMajor bool) (*ChangelogResult, error) {
+
+	versions, err := s.Git.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var sinceVersion *semver.Version
+	if since != "" {
+		sinceVersion, err = semver.NewVersion(since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since version %s: %w", since, err)
+		}
+	}
+
+	// Filter to the versions that actually get a section first. A skipped prerelease's
+	// commits must not be dropped: they belong to the next-newer kept version's section,
+	// which is why stopAt below is computed from kept's neighbours, not versions'.
+	kept := versions[:0]
+	for _, v := range versions {
+		if !includePrereleases && v.Version.Prerelease() != "" {
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	var content strings.Builder
+	var lastMajor int64 = -1
+
+	for i, v := range kept {
+
+		// Versions older than since get no section, but still bound the section of the
+		// oldest version that does.
+		if sinceVersion != nil && v.Version.LessThan(sinceVersion) {
+			break
+		}
+
+		// kept is sorted newest first, so the section for v stops at the next kept
+		// (older) release, or walks to the root commit for the oldest one.
+		stopAt := ""
+		if i+1 < len(kept) {
+			stopAt = kept[i+1].Hash
+		}
+
+		if groupByMajor && v.Version.Major() != lastMajor {
+			lastMajor = v.Version.Major()
+			fmt.Fprintf(&content, "# v%d\n\n", lastMajor)
+		}
+
+		fmt.Fprintf(&content, "## %s (%s)\n\n", v.Version.Original(), v.TaggerDate.Format("2006-01-02"))
+
+		section, err := s.GetChangelogForRange(v.Hash, stopAt)
+		if err != nil {
+			return nil, err
+		}
+
+		content.WriteString(section.Content)
+		content.WriteString("\n")
+	}
+
+	return &ChangelogResult{Content: content.String()}, nil
+}
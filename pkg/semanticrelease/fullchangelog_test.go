@@ -0,0 +1,89 @@
+package semanticrelease
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// initTaggedRepo creates a throwaway git repository with a final release, a prerelease
+// built on top of it, and a later final release, v1.1.0 -> v1.2.0-rc.1 -> v1.2.0.
+func initTaggedRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s: %s", args, err, out)
+		}
+	}
+
+	run("init")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "test")
+
+	filePath := filepath.Join(dir, "file.txt")
+
+	commit := func(subject string) {
+		existing, _ := ioutil.ReadFile(filePath)
+		if err := ioutil.WriteFile(filePath, append(existing, []byte(subject+"\n")...), 0644); err != nil {
+			t.Fatalf("could not write file: %s", err)
+		}
+		run("add", ".")
+		run("commit", "-m", "feat: "+subject)
+	}
+
+	commit("first")
+	run("tag", "v1.1.0")
+
+	commit("rc work")
+	run("tag", "v1.2.0-rc.1")
+
+	commit("final touches")
+	run("tag", "v1.2.0")
+
+	return dir
+}
+
+func TestGenerateFullChangelogKeepsPrereleaseCommits(t *testing.T) {
+
+	s, err := New(nil, initTaggedRepo(t))
+	if err != nil {
+		t.Fatalf("could not open repository: %s", err)
+	}
+
+	changelog, err := s.GenerateFullChangelog("", false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(changelog.Content, "rc work") {
+		t.Fatalf("expected the skipped prerelease's commit to be merged into the next kept section, got:\n%s", changelog.Content)
+	}
+
+	if strings.Contains(changelog.Content, "v1.2.0-rc.1") {
+		t.Fatalf("a skipped prerelease should not get its own heading, got:\n%s", changelog.Content)
+	}
+}
+
+func TestGenerateFullChangelogIncludesPrereleasesWhenAsked(t *testing.T) {
+
+	s, err := New(nil, initTaggedRepo(t))
+	if err != nil {
+		t.Fatalf("could not open repository: %s", err)
+	}
+
+	changelog, err := s.GenerateFullChangelog("", true, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(changelog.Content, "v1.2.0-rc.1") {
+		t.Fatalf("expected the prerelease to get its own heading with --include-prereleases, got:\n%s", changelog.Content)
+	}
+}
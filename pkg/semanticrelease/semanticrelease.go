@@ -0,0 +1,42 @@
+// Package semanticrelease ties gitutil, the conventional-commit analyzer and the
+// changelog templates together into the next-version/changelog/tag pipeline the
+// cobra commands drive.
+package semanticrelease
+
+import (
+	"github.com/Nightapes/go-semantic-release/internal/gitutil"
+)
+
+// Release drives the release pipeline for a single repository.
+type Release struct {
+	Git        *gitutil.GitUtil
+	configFile interface{}
+	checks     bool
+}
+
+// New opens the repository at folder and prepares a Release for it. configChecks
+// enables the --checks validation some commands opt into; it defaults to false when
+// the argument is omitted.
+func New(config interface{}, folder string, configChecks ...bool) (*Release, error) {
+
+	g, err := gitutil.New(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := false
+	if len(configChecks) > 0 {
+		checks = configChecks[0]
+	}
+
+	return &Release{
+		Git:        g,
+		configFile: config,
+		checks:     checks,
+	}, nil
+}
+
+// ChangelogResult is the rendered output of a changelog generation call.
+type ChangelogResult struct {
+	Content string
+}
@@ -0,0 +1,27 @@
+package semanticrelease
+
+// CreateTag creates a git tag named version. When annotated is true the tag body is the
+// changelog for the commits since the last release, and, if signingKeyPath is non-empty,
+// the tag is GPG-signed with that key. Resolving whether to sign at all, and which key to
+// use when --sign is passed without --signing-key, is the caller's job.
+func (s *Release) CreateTag(version string, annotated bool, signingKeyPath string) error {
+
+	message := ""
+
+	if annotated {
+		_, lastTagHash, err := s.Git.GetLastVersion()
+		if err != nil {
+			return err
+		}
+
+		changelog, err := s.GetChangelogForRange("HEAD", lastTagHash)
+		if err != nil {
+			return err
+		}
+
+		message = changelog.Content
+	}
+
+	_, err := s.Git.CreateTag(version, message, annotated, signingKeyPath)
+	return err
+}